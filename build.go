@@ -0,0 +1,129 @@
+package container
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// visitState tracks DFS progress per abstraction while walking the dependency graph.
+type visitState int
+
+const (
+	unvisited visitState = iota
+	visiting
+	visited
+)
+
+// Build validates the dependency graph of the container: for every binding registered on this
+// container, under any name, it walks the resolver's argument types (resolved, like arguments(),
+// against the default binding name, and falling back to the parent chain exactly like a real
+// resolve would) and fails fast if a dependency is missing, or if bindings form a cycle - a
+// resolver that directly or transitively depends on its own output. A lazy factory argument
+// (func() Shape) or an optional pointer argument (*Shape) is unwrapped to its element type first,
+// the same way arguments() treats them at Make time; an optional argument's element is walked but
+// never required, since a missing binding there is tolerated, not an error.
+func (c *container) Build() error {
+	states := map[reflect.Type]visitState{}
+
+	var visitArgs func(owner reflect.Type, b binding, path []reflect.Type) error
+	var visitDefault func(t reflect.Type, path []reflect.Type) error
+
+	visitArgs = func(owner reflect.Type, b binding, path []reflect.Type) error {
+		resolverType := reflect.TypeOf(b.resolver)
+		for i := 0; i < resolverType.NumIn(); i++ {
+			argType := resolverType.In(i)
+
+			if elem, ok := factoryArgumentElem(argType); ok {
+				argType = elem
+			} else if elem, ok := optionalArgumentElem(argType); ok {
+				if err := visitDefault(elem, append(path, owner)); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if _, ok := c.lookupBindingChain(argType, defaultBindingName); !ok {
+				return fmt.Errorf("%w: %s required by %s", ErrNoBinding, argType.String(), owner.String())
+			}
+			if err := visitDefault(argType, append(path, owner)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	visitDefault = func(t reflect.Type, path []reflect.Type) error {
+		switch states[t] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("container: dependency cycle detected: %s", cyclePath(append(path, t)))
+		}
+
+		b, ok := c.lookupBindingChain(t, defaultBindingName)
+		if !ok {
+			return nil
+		}
+
+		states[t] = visiting
+		if err := visitArgs(t, b, path); err != nil {
+			return err
+		}
+		states[t] = visited
+
+		return nil
+	}
+
+	for _, pair := range c.ownBindingNames() {
+		if pair.name == defaultBindingName {
+			if err := visitDefault(pair.abstraction, nil); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// A non-default-named binding is never itself an argument-resolution target (arguments()
+		// always resolves against the default name), so it can't take part in a cycle, but its own
+		// resolver's arguments still need to be validated.
+		b, ok := c.lookupBinding(pair.abstraction, pair.name)
+		if !ok {
+			continue
+		}
+		if err := visitArgs(pair.abstraction, b, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// bindingKey identifies a single binding by its abstraction and name.
+type bindingKey struct {
+	abstraction reflect.Type
+	name        string
+}
+
+// ownBindingNames lists every (abstraction, name) pair registered directly on this container, not
+// its ancestors.
+func (c *container) ownBindingNames() []bindingKey {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	keys := make([]bindingKey, 0, len(c.bindings))
+	for abstraction, bindings := range c.bindings {
+		for name := range bindings {
+			keys = append(keys, bindingKey{abstraction: abstraction, name: name})
+		}
+	}
+	return keys
+}
+
+// cyclePath renders a dependency path as e.g. "Foo -> Bar -> Foo".
+func cyclePath(path []reflect.Type) string {
+	names := make([]string, len(path))
+	for i, t := range path {
+		names[i] = t.String()
+	}
+	return strings.Join(names, " -> ")
+}
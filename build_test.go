@@ -0,0 +1,142 @@
+package container_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/atrico-go/container"
+)
+
+func TestBuildItShouldReturnNilForAValidGraph(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton(func() Shape {
+		return &Circle{a: 5}
+	})
+	c.Singleton(func(s Shape) Database {
+		return &MySQL{}
+	})
+
+	assert.NoError(t, c.Build())
+}
+
+func TestBuildItShouldReturnErrorForAMissingDependency(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton(func(s Shape) Database {
+		return &MySQL{}
+	})
+
+	err := c.Build()
+
+	assert.True(t, errors.Is(err, container.ErrNoBinding))
+}
+
+type Foo interface {
+	Foo() string
+}
+
+type Bar interface {
+	Bar() string
+}
+
+type fooImpl struct{ bar Bar }
+
+func (f *fooImpl) Foo() string { return "foo" }
+
+type barImpl struct{ foo Foo }
+
+func (b *barImpl) Bar() string { return "bar" }
+
+func TestBuildItShouldReturnErrorForACycle(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton(func(b Bar) Foo {
+		return &fooImpl{bar: b}
+	})
+	c.Singleton(func(f Foo) Bar {
+		return &barImpl{foo: f}
+	})
+
+	err := c.Build()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "dependency cycle detected")
+}
+
+func TestBuildItShouldReturnErrorWhenDependencyIsOnlyBoundUnderAName(t *testing.T) {
+	c := container.NewContainer()
+	c.SingletonNamed("alt", func() Shape {
+		return &Circle{a: 5}
+	})
+	c.Singleton(func(s Shape) Database {
+		return &MySQL{}
+	})
+
+	err := c.Build()
+
+	assert.True(t, errors.Is(err, container.ErrNoBinding))
+}
+
+func TestBuildItShouldValidateResolversOfNonDefaultNamedBindings(t *testing.T) {
+	c := container.NewContainer()
+	c.SingletonNamed("alt", func(s Shape) Database {
+		return &MySQL{}
+	})
+
+	err := c.Build()
+
+	assert.True(t, errors.Is(err, container.ErrNoBinding))
+}
+
+func TestBuildItShouldReturnNilForAValidGraphWithAFactoryArgument(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton(func() Shape {
+		return &Circle{a: 5}
+	})
+	c.Singleton(func(newShape func() Shape) Database {
+		return &MySQL{}
+	})
+
+	assert.NoError(t, c.Build())
+}
+
+func TestBuildItShouldReturnNilForAValidGraphWithAnOptionalArgument(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton(func() Shape {
+		return &Circle{a: 5}
+	})
+	c.Singleton(func(s *Shape) Database {
+		return &MySQL{}
+	})
+
+	assert.NoError(t, c.Build())
+}
+
+func TestBuildItShouldTolerateAnUnboundOptionalArgument(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton(func(s *Shape) Database {
+		return &MySQL{}
+	})
+
+	assert.NoError(t, c.Build())
+}
+
+func TestBuildOnChildContainerItShouldReturnErrorForACycleReachableThroughParentBindings(t *testing.T) {
+	parent := container.NewContainer()
+	parent.Singleton(func(b Bar) Foo {
+		return &fooImpl{bar: b}
+	})
+	parent.Singleton(func(f Foo) Bar {
+		return &barImpl{foo: f}
+	})
+
+	child := container.NewChildContainer(parent)
+	child.Singleton(func(f Foo) Database {
+		return &MySQL{}
+	})
+
+	err := child.Build()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "dependency cycle detected")
+}
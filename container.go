@@ -3,141 +3,366 @@
 package container
 
 import (
+	"fmt"
 	"reflect"
+	"sync"
 )
 
+// defaultBindingName is the name used for bindings registered through the unnamed API.
+const defaultBindingName = ""
+
+// errorType is used to detect a resolver's optional trailing error return value.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
 // Container interface
 type Container interface {
 	// Singleton will bind an abstraction to a concrete for further singleton resolves.
 	// It takes a resolver function which returns the concrete and its return type matches the abstraction (interface).
-	// The resolver function can have arguments of abstraction that have bound already in Container.
-	Singleton(resolver interface{})
+	// The resolver function can have arguments of abstraction that have bound already in Container, and may
+	// optionally return a trailing error which aborts resolution when non-nil.
+	Singleton(resolver interface{}) error
+	// SingletonNamed works like Singleton but registers the binding under the given name, allowing
+	// multiple concretes of the same abstraction to coexist (resolved later via MakeNamed).
+	SingletonNamed(name string, resolver interface{}) error
 	// Transient will bind an abstraction to a concrete for further transient resolves.
 	// It takes a resolver function which returns the concrete and its return type matches the abstraction (interface).
-	// The resolver function can have arguments of abstraction that have bound already in Container.
-	Transient(resolver interface{})
-	// Reset will reset the container and remove all the bindings.
+	// The resolver function can have arguments of abstraction that have bound already in Container, and may
+	// optionally return a trailing error which aborts resolution when non-nil.
+	Transient(resolver interface{}) error
+	// TransientNamed works like Transient but registers the binding under the given name, allowing
+	// multiple concretes of the same abstraction to coexist (resolved later via MakeNamed).
+	TransientNamed(name string, resolver interface{}) error
+	// Reset will reset the container and remove all the bindings. Bindings inherited from a parent
+	// container (see NewChildContainer) are not affected.
 	Reset()
 	// Make will resolve the dependency and return a appropriate concrete of the given abstraction.
 	// It can take an abstraction (interface reference) and fill it with the related implementation.
 	// It also can takes a function (receiver) with one or more arguments of the abstractions (interfaces) that need to be
 	// resolved, Container will invoke the receiver function and pass the related implementations.
-	Make(receiver interface{})
+	Make(receiver interface{}) error
+	// MakeNamed works like Make but resolves the binding registered under the given name.
+	MakeNamed(name string, receiver interface{}) error
+	// Fill takes a pointer to a struct and injects resolved concretes into its exported fields.
+	// Fields tagged with `container:"inject"` are always injected; untagged interface fields are
+	// injected on a best-effort basis. See DeepFill for nested struct support.
+	Fill(structPtr interface{}) error
+	// DeepFill works like Fill but recurses into nested (or pointer-to) struct fields. An optional
+	// override struct pointer may be passed whose matching fields take precedence over container
+	// bindings, which is handy for per-request values the container itself doesn't own.
+	DeepFill(structPtr interface{}, override ...interface{}) error
+	// Build validates the dependency graph once all bindings are registered. It walks every
+	// resolver's argument types and returns an error if a dependency is missing, or if resolvers
+	// form a cycle, so callers can catch wiring mistakes up front instead of at first Make.
+	Build() error
+	// Merge flat-copies every binding of other into this container, overwriting any binding this
+	// container already has for the same abstraction and name. Unlike NewChildContainer, the
+	// result is a single, independent set of bindings rather than a parent/child lookup chain -
+	// useful for composing separately-built module containers.
+	Merge(other Container) error
 }
 
 // Create default container
 func NewContainer() Container {
-	return &container{}
+	return &container{bindings: map[reflect.Type]map[string]binding{}}
+}
+
+// NewChildContainer creates a container that resolves bindings registered on itself first, falling
+// back to parent (and its own ancestors) for anything it doesn't have. A singleton is cached on
+// whichever container owns its binding, not on the container that triggered the resolve, so a
+// parent-owned singleton is shared by every child that resolves it.
+func NewChildContainer(parent Container) Container {
+	p, _ := parent.(*container)
+	return &container{bindings: map[reflect.Type]map[string]binding{}, parent: p}
 }
 
 // Default implementation of Container
-// container is the IoC container that will keep all of the bindings.
-type container map[reflect.Type]binding
+// container is the IoC container that keeps all of the bindings, keyed by abstraction and then by
+// name, optionally falling back to a parent container for anything it doesn't itself bind.
+// It is safe for concurrent use by multiple goroutines: mu guards bindings, and each binding's own
+// mutex serializes concurrent resolution of that one singleton.
+type container struct {
+	mu       sync.RWMutex
+	bindings map[reflect.Type]map[string]binding
+	parent   *container
+}
+
+func (c *container) Singleton(resolver interface{}) error {
+	return c.bind(defaultBindingName, resolver, true)
+}
+
+func (c *container) SingletonNamed(name string, resolver interface{}) error {
+	return c.bind(name, resolver, true)
+}
 
-func (c *container) Singleton(resolver interface{}) {
-	c.bind(resolver, true)
+func (c *container) Transient(resolver interface{}) error {
+	return c.bind(defaultBindingName, resolver, false)
 }
 
-func (c *container) Transient(resolver interface{}) {
-	c.bind(resolver, false)
+func (c *container) TransientNamed(name string, resolver interface{}) error {
+	return c.bind(name, resolver, false)
 }
 
-// Reset will reset the container and remove all the bindings.
+// Reset will reset the container and remove all the bindings. Bindings inherited from a parent
+// container (see NewChildContainer) are not affected.
 func (c *container) Reset() {
-	*c = map[reflect.Type]binding{}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.bindings = map[reflect.Type]map[string]binding{}
+}
+
+// Merge flat-copies every binding of other into this container, overwriting any binding this
+// container already has for the same abstraction and name.
+func (c *container) Merge(other Container) error {
+	o, ok := other.(*container)
+	if !ok {
+		return fmt.Errorf("%w: Merge requires another container created by this package", ErrInvalidResolver)
+	}
+
+	o.mu.RLock()
+	snapshot := make(map[reflect.Type]map[string]binding, len(o.bindings))
+	for abstraction, bindings := range o.bindings {
+		copyOfBindings := make(map[string]binding, len(bindings))
+		for name, b := range bindings {
+			copyOfBindings[name] = b
+		}
+		snapshot[abstraction] = copyOfBindings
+	}
+	o.mu.RUnlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for abstraction, bindings := range snapshot {
+		if c.bindings[abstraction] == nil {
+			c.bindings[abstraction] = map[string]binding{}
+		}
+		for name, b := range bindings {
+			c.bindings[abstraction][name] = b
+		}
+	}
+
+	return nil
 }
 
 // Make will resolve the dependency and return a appropriate concrete of the given abstraction.
 // It can take an abstraction (interface reference) and fill it with the related implementation.
 // It also can takes a function (receiver) with one or more arguments of the abstractions (interfaces) that need to be
 // resolved, Container will invoke the receiver function and pass the related implementations.
-func (c *container) Make(receiver interface{}) {
+func (c *container) Make(receiver interface{}) error {
+	return c.make(defaultBindingName, receiver)
+}
+
+// MakeNamed works like Make but resolves the binding registered under the given name.
+func (c *container) MakeNamed(name string, receiver interface{}) error {
+	return c.make(name, receiver)
+}
+
+func (c *container) make(name string, receiver interface{}) error {
 	receiverTypeOf := reflect.TypeOf(receiver)
 	if receiverTypeOf == nil {
-		panic("cannot detect type of the receiver, make sure your are passing reference of the object")
+		return fmt.Errorf("%w: cannot detect type of the receiver, make sure your are passing reference of the object", ErrInvalidReceiver)
 	}
 
 	if receiverTypeOf.Kind() == reflect.Ptr {
 		abstraction := receiverTypeOf.Elem()
 
-		if instance := c.resolve(abstraction); instance != nil {
+		instance, err := c.resolve(abstraction, name)
+		if err != nil {
+			return err
+		}
+		if instance != nil {
 			reflect.ValueOf(receiver).Elem().Set(reflect.ValueOf(instance))
-			return
+			return nil
 		}
 
-		panic("no concrete found for the abstraction " + abstraction.String())
+		return fmt.Errorf("%w: %s", ErrNoBinding, abstraction.String())
 	}
 
 	if receiverTypeOf.Kind() == reflect.Func {
-		arguments := c.arguments(receiver)
+		arguments, err := c.arguments(receiver, name)
+		if err != nil {
+			return err
+		}
 		reflect.ValueOf(receiver).Call(arguments)
-		return
+		return nil
 	}
 
-	panic("the receiver must be either a reference or a callback")
+	return ErrInvalidReceiver
 }
 
-// invoke will call the given function and return its returned value.
-// It only works for functions that return a single value.
-func (c *container) invoke(function interface{}) interface{} {
-	return reflect.ValueOf(function).Call(c.arguments(function))[0].Interface()
+// invoke will call the given function and return its single (non-error) returned value. The
+// resolver may optionally declare a trailing error return; when non-nil it aborts resolution.
+func (c *container) invoke(function interface{}) (interface{}, error) {
+	arguments, err := c.arguments(function, defaultBindingName)
+	if err != nil {
+		return nil, err
+	}
+
+	results := reflect.ValueOf(function).Call(arguments)
+	if last := results[len(results)-1]; last.Type() == errorType {
+		if resolverErr, _ := last.Interface().(error); resolverErr != nil {
+			return nil, fmt.Errorf("%w: %v", ErrResolverFailed, resolverErr)
+		}
+	}
+
+	return results[0].Interface(), nil
 }
 
-// bind will map an abstraction to a concrete.
-func (c *container) bind(resolver interface{}, singleton bool) {
+// bind will map an abstraction, under the given name, to a concrete. A resolver may declare a
+// trailing error return value, which is not itself bound as an abstraction.
+func (c *container) bind(name string, resolver interface{}, singleton bool) error {
 	resolverTypeOf := reflect.TypeOf(resolver)
-	if resolverTypeOf.Kind() != reflect.Func {
-		panic("the resolver must be a function")
+	if resolverTypeOf == nil || resolverTypeOf.Kind() != reflect.Func {
+		return ErrInvalidResolver
+	}
+
+	numOut := resolverTypeOf.NumOut()
+	if numOut == 0 {
+		return fmt.Errorf("%w: the resolver must return at least one value", ErrInvalidResolver)
 	}
 
-	for i := 0; i < resolverTypeOf.NumOut(); i++ {
-		(*c)[resolverTypeOf.Out(i)] = binding{
-			resolver: resolver,
-			instance: nil,
+	concreteCount := numOut
+	if numOut > 1 && resolverTypeOf.Out(numOut-1) == errorType {
+		concreteCount--
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i := 0; i < concreteCount; i++ {
+		outType := resolverTypeOf.Out(i)
+		if c.bindings[outType] == nil {
+			c.bindings[outType] = map[string]binding{}
+		}
+		c.bindings[outType][name] = binding{
+			resolver:  resolver,
+			instance:  nil,
 			singleton: singleton,
+			mu:        &sync.Mutex{},
 		}
 	}
+
+	return nil
 }
 
-// arguments will return resolved arguments of the given function.
-func (c *container) arguments(function interface{}) []reflect.Value {
+// arguments will return resolved arguments of the given function, resolved under the given binding
+// name. invoke always resolves a resolver's own arguments against defaultBindingName; make passes
+// through whatever name Make/MakeNamed was called with, so a func receiver's parameters honor
+// MakeNamed the same way a pointer receiver does. Use Fill for per-field named injection instead.
+// An argument shaped like a nullary factory (func() Shape) or a pointer-to-interface (*Shape) gets
+// the special lazy/optional treatment described on resolveFactoryArgument and
+// resolveOptionalArgument; anything else is resolved eagerly and must already be bound.
+func (c *container) arguments(function interface{}, name string) ([]reflect.Value, error) {
 	functionTypeOf := reflect.TypeOf(function)
 	argumentsCount := functionTypeOf.NumIn()
 	arguments := make([]reflect.Value, argumentsCount)
 
 	for i := 0; i < argumentsCount; i++ {
-		abstraction := functionTypeOf.In(i)
-		instance := c.resolve(abstraction)
-		if  instance == nil {
-			panic("no concrete found for the abstraction: " + abstraction.String())
+		argType := functionTypeOf.In(i)
+
+		if value, ok := c.resolveFactoryArgument(argType, name); ok {
+			arguments[i] = value
+			continue
+		}
+
+		if value, ok, err := c.resolveOptionalArgument(argType, name); ok {
+			if err != nil {
+				return nil, err
+			}
+			arguments[i] = value
+			continue
+		}
+
+		instance, err := c.resolve(argType, name)
+		if err != nil {
+			return nil, err
+		}
+		if instance == nil {
+			return nil, fmt.Errorf("%w: %s", ErrNoBinding, argType.String())
 		}
 		arguments[i] = reflect.ValueOf(instance)
 	}
 
-	return arguments
+	return arguments, nil
 }
 
-// resolve will return the concrete of related abstraction.
-func (c *container) resolve(abstraction reflect.Type) interface{} {
-	if b, ok := (*c)[abstraction]; ok {
-		// Return singleton if already resolved
-		if b.instance != nil {
-			return b.instance
-		}
-		instance := c.invoke(b.resolver)
-		if b.singleton {
-			b.instance = instance
-			(*c)[abstraction] = b
+// resolve will return the concrete bound to the given abstraction under the given name, falling
+// back to the parent container (if any) when this container has no such binding. It returns
+// (nil, nil) if no binding exists anywhere in the chain. A transient binding is invoked on every
+// call. A singleton binding's resolver runs at most once *on success*: concurrent callers racing
+// to resolve the same singleton are serialized on the binding's own mutex and share the one
+// invocation's result, but a resolver that errors (or panics) is not cached as "done" - the next
+// caller retries it, and the real error keeps propagating instead of degrading to ErrNoBinding.
+func (c *container) resolve(abstraction reflect.Type, name string) (interface{}, error) {
+	b, ok := c.lookupBinding(abstraction, name)
+	if !ok {
+		if c.parent != nil {
+			return c.parent.resolve(abstraction, name)
 		}
-		return instance
+		return nil, nil
 	}
-	return nil
+
+	if !b.singleton {
+		return c.invoke(b.resolver)
+	}
+
+	if b.instance != nil {
+		return b.instance, nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// Another goroutine may have resolved (or be resolving) this singleton while we waited for
+	// the lock; re-check before invoking the resolver ourselves.
+	if current, ok := c.lookupBinding(abstraction, name); ok && current.instance != nil {
+		return current.instance, nil
+	}
+
+	instance, err := c.invoke(b.resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cache only if the binding is still the one we invoked: a concurrent Reset or rebind may
+	// have removed or replaced it while the resolver ran.
+	c.mu.Lock()
+	if stored, ok := c.bindings[abstraction][name]; ok && stored.mu == b.mu {
+		stored.instance = instance
+		c.bindings[abstraction][name] = stored
+	}
+	c.mu.Unlock()
+
+	return instance, nil
+}
+
+// lookupBinding returns the binding registered for abstraction under name on this container.
+func (c *container) lookupBinding(abstraction reflect.Type, name string) (binding, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	b, ok := c.bindings[abstraction][name]
+	return b, ok
+}
+
+// lookupBindingChain is like lookupBinding but, on a miss, falls back to the parent chain - the
+// same precedence resolve() itself uses. It's read-only and doesn't trigger invocation or
+// singleton caching; Build() uses it to statically validate a resolver's dependencies the same
+// way they'll actually be looked up at Make time.
+func (c *container) lookupBindingChain(abstraction reflect.Type, name string) (binding, bool) {
+	if b, ok := c.lookupBinding(abstraction, name); ok {
+		return b, true
+	}
+	if c.parent != nil {
+		return c.parent.lookupBindingChain(abstraction, name)
+	}
+	return binding{}, false
 }
 
-// binding keeps a binding resolver and instance (for singleton bindings).
+// binding keeps a binding resolver and instance (for singleton bindings). mu serializes concurrent
+// singleton resolution so the resolver runs at most once on success, while still allowing a later
+// caller to retry after a failed invocation.
 type binding struct {
 	resolver  interface{} // resolver function
-	instance  interface{} // instance stored for singleton bindings (on first resolve)
+	instance  interface{} // instance stored for singleton bindings (on first successful resolve)
 	singleton bool
+	mu        *sync.Mutex
 }
-
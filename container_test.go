@@ -1,6 +1,7 @@
 package container_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -39,14 +40,14 @@ func TestSingletonItShouldMakeAnInstanceOfTheAbstraction(t *testing.T) {
 	area := 5
 
 	c := container.NewContainer()
-	c.Singleton(func() Shape {
+	assert.NoError(t, c.Singleton(func() Shape {
 		return &Circle{a: area}
-	})
+	}))
 
-	c.Make(func(s Shape) {
+	assert.NoError(t, c.Make(func(s Shape) {
 		a := s.GetArea()
 		assert.Equal(t, area, a)
-	})
+	}))
 }
 
 func TestSingletonItShouldMakeSameObjectEachMake(t *testing.T) {
@@ -67,12 +68,10 @@ func TestSingletonItShouldMakeSameObjectEachMake(t *testing.T) {
 	})
 }
 
-func TestSingletonWithNonFunctionResolverItShouldPanic(t *testing.T) {
-	value := "the resolver must be a function"
+func TestSingletonWithNonFunctionResolverItShouldReturnError(t *testing.T) {
 	c := container.NewContainer()
-	assert.PanicsWithValue(t, value, func() {
-		c.Singleton("STRING!")
-	}, "Expected panic")
+	err := c.Singleton("STRING!")
+	assert.True(t, errors.Is(err, container.ErrInvalidResolver))
 }
 
 func TestSingletonItShouldResolveResolverArguments(t *testing.T) {
@@ -82,10 +81,27 @@ func TestSingletonItShouldResolveResolverArguments(t *testing.T) {
 		return &Circle{a: area}
 	})
 
-	c.Singleton(func(s Shape) Database {
+	err := c.Singleton(func(s Shape) Database {
 		assert.Equal(t, s.GetArea(), area)
 		return &MySQL{}
 	})
+	assert.NoError(t, err)
+	// Singletons resolve lazily, so the resolver (and its argument check) runs on Make.
+	assert.NoError(t, c.Make(func(d Database) {}))
+}
+
+func TestSingletonItShouldAbortResolutionWhenResolverReturnsAnError(t *testing.T) {
+	resolverErr := errors.New("cannot connect")
+	c := container.NewContainer()
+	c.Singleton(func() (Database, error) {
+		return nil, resolverErr
+	})
+
+	err := c.Make(func(d Database) {
+		t.Error("receiver should not be invoked")
+	})
+
+	assert.True(t, errors.Is(err, container.ErrResolverFailed))
 }
 
 func TestTransientItShouldMakeDifferentObjectsOnMake(t *testing.T) {
@@ -196,41 +212,136 @@ func TestMakeWithMultipleInputsAndReference(t *testing.T) {
 	}
 }
 
-func TestMakeWithUnsupportedReceiver(t *testing.T) {
-	value := "the receiver must be either a reference or a callback"
+func TestMakeWithUnsupportedReceiverItShouldReturnError(t *testing.T) {
 	c := container.NewContainer()
-	assert.PanicsWithValue(t, value, func() {
-		c.Make("STRING!")
-	}, "Expected panic")
+	err := c.Make("STRING!")
+	assert.True(t, errors.Is(err, container.ErrInvalidReceiver))
 }
 
-func TestMakeWithNonReference(t *testing.T) {
-	value := "cannot detect type of the receiver, make sure your are passing reference of the object"
+func TestMakeWithNonReferenceItShouldReturnError(t *testing.T) {
 	c := container.NewContainer()
-	assert.PanicsWithValue(t, value, func() {
-		var s Shape
-		c.Make(s)
-	}, "Expected panic")
+	var s Shape
+	err := c.Make(s)
+	assert.True(t, errors.Is(err, container.ErrInvalidReceiver))
+}
+
+func TestMakeWithUnboundedAbstractionItShouldReturnError(t *testing.T) {
+	c := container.NewContainer()
+	var s Shape
+	c.Reset()
+	err := c.Make(&s)
+	assert.True(t, errors.Is(err, container.ErrNoBinding))
 }
 
-func TestMakeWithUnboundedAbstraction(t *testing.T) {
-	value := "no concrete found for the abstraction container_test.Shape"
+func TestMakeWithCallbackThatHasAUnboundedAbstractionItShouldReturnError(t *testing.T) {
 	c := container.NewContainer()
-	assert.PanicsWithValue(t, value, func() {
+	c.Reset()
+	c.Singleton(func() Shape {
+		return &Circle{}
+	})
+	err := c.Make(func(s Shape, d Database) {})
+	assert.True(t, errors.Is(err, container.ErrNoBinding))
+}
+
+func TestMustSingletonItShouldPanicOnInvalidResolver(t *testing.T) {
+	c := container.NewContainer()
+	assert.Panics(t, func() {
+		container.MustSingleton(c, "STRING!")
+	})
+}
+
+func TestMustMakeItShouldPanicOnUnboundedAbstraction(t *testing.T) {
+	c := container.NewContainer()
+	assert.Panics(t, func() {
 		var s Shape
-		c.Reset()
-		c.Make(&s)
-	}, "Expected panic")
+		container.MustMake(c, &s)
+	})
+}
+
+type Rectangle struct {
+	a int
 }
 
-func TestMakeWithCallbackThatHasAUnboundedAbstraction(t *testing.T) {
-	value := "no concrete found for the abstraction: container_test.Database"
+func (r *Rectangle) SetArea(a int) {
+	r.a = a
+}
+
+func (r Rectangle) GetArea() int {
+	return r.a
+}
+
+func TestSingletonNamedItShouldResolveTheMatchingName(t *testing.T) {
 	c := container.NewContainer()
-	assert.PanicsWithValue(t, value, func() {
-		c.Reset()
-		c.Singleton(func() Shape {
-			return &Circle{}
-		})
-		c.Make(func(s Shape, d Database) {})
-	}, "Expected panic")
+	c.SingletonNamed("circle", func() Shape {
+		return &Circle{a: 5}
+	})
+	c.SingletonNamed("rectangle", func() Shape {
+		return &Rectangle{a: 10}
+	})
+
+	var s Shape
+	c.MakeNamed("rectangle", &s)
+
+	if _, ok := s.(*Rectangle); !ok {
+		t.Error("Expected Rectangle")
+	}
+}
+
+func TestSingletonNamedItShouldKeepTheUnnamedBindingAsDefault(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton(func() Shape {
+		return &Circle{a: 5}
+	})
+	c.SingletonNamed("rectangle", func() Shape {
+		return &Rectangle{a: 10}
+	})
+
+	var s Shape
+	c.Make(&s)
+
+	if _, ok := s.(*Circle); !ok {
+		t.Error("Expected Circle")
+	}
+}
+
+func TestTransientNamedItShouldMakeDifferentObjectsOnMake(t *testing.T) {
+	c := container.NewContainer()
+	c.TransientNamed("rectangle", func() Shape {
+		return &Rectangle{a: 5}
+	})
+
+	assert.NoError(t, c.MakeNamed("rectangle", func(s1 Shape) {
+		s1.SetArea(6)
+	}))
+
+	assert.NoError(t, c.MakeNamed("rectangle", func(s2 Shape) {
+		a := s2.GetArea()
+		assert.Equal(t, 5, a)
+	}))
+}
+
+func TestMakeNamedWithAFuncReceiverItShouldResolveItsArgumentsUnderTheGivenName(t *testing.T) {
+	c := container.NewContainer()
+	c.TransientNamed("rectangle", func() Shape {
+		return &Rectangle{a: 10}
+	})
+
+	called := false
+	err := c.MakeNamed("rectangle", func(s Shape) {
+		called = true
+		assert.Equal(t, 10, s.GetArea())
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestMakeNamedWithUnboundedNameItShouldReturnError(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton(func() Shape {
+		return &Circle{a: 5}
+	})
+	var s Shape
+	err := c.MakeNamed("rectangle", &s)
+	assert.True(t, errors.Is(err, container.ErrNoBinding))
 }
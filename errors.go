@@ -0,0 +1,19 @@
+package container
+
+import "errors"
+
+// Sentinel errors returned by Container operations. Use errors.Is to check a returned error
+// against one of these, or errors.As to unwrap it for more detail.
+var (
+	// ErrNoBinding is returned when Make, MakeNamed or Fill cannot find a binding for the
+	// requested abstraction.
+	ErrNoBinding = errors.New("container: no concrete found for the abstraction")
+	// ErrInvalidResolver is returned when Singleton or Transient is given something other than
+	// a function.
+	ErrInvalidResolver = errors.New("container: the resolver must be a function")
+	// ErrInvalidReceiver is returned when Make is given something other than a reference or a
+	// callback.
+	ErrInvalidReceiver = errors.New("container: the receiver must be either a reference or a callback")
+	// ErrResolverFailed is returned when a resolver's trailing error return value is non-nil.
+	ErrResolverFailed = errors.New("container: resolver failed")
+)
@@ -0,0 +1,124 @@
+package container
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// injectTag is the struct tag key inspected by Fill/DeepFill.
+const injectTag = "container"
+
+// Fill takes a pointer to a struct and injects resolved concretes into its exported fields.
+// Fields tagged with `container:"inject"` (optionally `container:"inject,name=primary"` for a named
+// binding) are always injected, and Fill returns an error if no matching binding exists. Untagged
+// interface fields are injected on a best-effort basis and silently left untouched when unbound.
+func (c *container) Fill(structPtr interface{}) error {
+	return c.fillValue(reflect.ValueOf(structPtr), reflect.Value{}, false, map[reflect.Type]bool{})
+}
+
+// DeepFill works like Fill but recurses into nested struct (and pointer-to-struct) fields. The
+// optional override struct pointer has its matching fields take precedence over container
+// bindings, useful for per-request injection of values the container doesn't own, e.g.
+// (w http.ResponseWriter, r *http.Request, session).
+func (c *container) DeepFill(structPtr interface{}, override ...interface{}) error {
+	var overrideValue reflect.Value
+	if len(override) > 0 {
+		overrideValue = reflect.ValueOf(override[0])
+	}
+	return c.fillValue(reflect.ValueOf(structPtr), overrideValue, true, map[reflect.Type]bool{})
+}
+
+// fillValue injects resolved concretes into the struct pointed to by ptr. When deep is true, nested
+// struct fields are filled recursively, guarded against cycles via the visiting set.
+func (c *container) fillValue(ptr reflect.Value, overridePtr reflect.Value, deep bool, visiting map[reflect.Type]bool) error {
+	if !ptr.IsValid() || ptr.Kind() != reflect.Ptr || ptr.IsNil() || ptr.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: Fill expects a pointer to a struct", ErrInvalidReceiver)
+	}
+
+	structValue := ptr.Elem()
+	structType := structValue.Type()
+
+	if visiting[structType] {
+		return fmt.Errorf("container: cycle detected while filling %v", structType)
+	}
+	visiting[structType] = true
+	defer delete(visiting, structType)
+
+	var overrideValue reflect.Value
+	if overridePtr.IsValid() && !overridePtr.IsNil() {
+		overrideValue = overridePtr.Elem()
+	}
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.PkgPath != "" { // unexported field, can't be set
+			continue
+		}
+
+		tag, tagged := field.Tag.Lookup(injectTag)
+		if tagged && tag == "-" {
+			continue
+		}
+
+		name := defaultBindingName
+		explicitInject := false
+		if tagged {
+			for _, part := range strings.Split(tag, ",") {
+				switch {
+				case part == "inject":
+					explicitInject = true
+				case strings.HasPrefix(part, "name="):
+					name = strings.TrimPrefix(part, "name=")
+				}
+			}
+		}
+
+		fieldValue := structValue.Field(i)
+
+		if overrideValue.IsValid() {
+			if overrideField := overrideValue.FieldByName(field.Name); overrideField.IsValid() &&
+				overrideField.Type() == field.Type && !overrideField.IsZero() {
+				fieldValue.Set(overrideField)
+				continue
+			}
+		}
+
+		if explicitInject {
+			instance, err := c.resolve(field.Type, name)
+			if err != nil {
+				return err
+			}
+			if instance == nil {
+				return fmt.Errorf("%w: field %s (%v)", ErrNoBinding, field.Name, field.Type)
+			}
+			fieldValue.Set(reflect.ValueOf(instance))
+			continue
+		}
+
+		if deep && field.Type.Kind() == reflect.Struct {
+			if err := c.fillValue(fieldValue.Addr(), reflect.Value{}, deep, visiting); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if deep && field.Type.Kind() == reflect.Ptr && field.Type.Elem().Kind() == reflect.Struct {
+			if fieldValue.IsNil() {
+				fieldValue.Set(reflect.New(field.Type.Elem()))
+			}
+			if err := c.fillValue(fieldValue, reflect.Value{}, deep, visiting); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !tagged && field.Type.Kind() == reflect.Interface {
+			if instance, err := c.resolve(field.Type, defaultBindingName); err == nil && instance != nil {
+				fieldValue.Set(reflect.ValueOf(instance))
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package container_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/atrico-go/container"
+)
+
+type App struct {
+	Shape    Shape    `container:"inject"`
+	Database Database `container:"inject,name=primary"`
+	Ignored  string
+}
+
+func TestFillItShouldInjectTaggedFields(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton(func() Shape {
+		return &Circle{a: 5}
+	})
+	c.SingletonNamed("primary", func() Database {
+		return &MySQL{}
+	})
+
+	app := App{Ignored: "keep me"}
+	err := c.Fill(&app)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, app.Shape.GetArea())
+	assert.True(t, app.Database.Connect())
+	assert.Equal(t, "keep me", app.Ignored)
+}
+
+func TestFillItShouldReturnErrorWhenTaggedFieldIsUnbound(t *testing.T) {
+	c := container.NewContainer()
+
+	app := App{}
+	err := c.Fill(&app)
+
+	assert.Error(t, err)
+}
+
+func TestFillItShouldReturnErrorForNonStructPointer(t *testing.T) {
+	c := container.NewContainer()
+
+	var notAStruct string
+	err := c.Fill(&notAStruct)
+
+	assert.Error(t, err)
+}
+
+type UntaggedApp struct {
+	Shape Shape
+}
+
+func TestFillItShouldBestEffortInjectUntaggedInterfaceFields(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton(func() Shape {
+		return &Circle{a: 7}
+	})
+
+	app := UntaggedApp{}
+	err := c.Fill(&app)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 7, app.Shape.GetArea())
+}
+
+type Request struct {
+	App     App
+	Session string
+}
+
+func TestDeepFillItShouldRecurseIntoNestedStructs(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton(func() Shape {
+		return &Circle{a: 5}
+	})
+	c.SingletonNamed("primary", func() Database {
+		return &MySQL{}
+	})
+
+	req := Request{}
+	err := c.DeepFill(&req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, req.App.Shape.GetArea())
+}
+
+type SkippedApp struct {
+	Shape Shape `container:"-"`
+}
+
+func TestFillItShouldSkipFieldsTaggedDash(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton(func() Shape {
+		return &Circle{a: 5}
+	})
+
+	app := SkippedApp{}
+	err := c.Fill(&app)
+
+	assert.NoError(t, err)
+	assert.Nil(t, app.Shape)
+}
+
+func TestDeepFillItShouldLetOverrideFieldsTakePrecedence(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton(func() Shape {
+		return &Circle{a: 5}
+	})
+	c.SingletonNamed("primary", func() Database {
+		return &MySQL{}
+	})
+
+	req := Request{}
+	override := Request{Session: "abc123"}
+
+	err := c.DeepFill(&req, &override)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "abc123", req.Session)
+}
@@ -0,0 +1,97 @@
+package container_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/atrico-go/container"
+)
+
+func TestChildContainerItShouldFallBackToParentBindings(t *testing.T) {
+	parent := container.NewContainer()
+	parent.Singleton(func() Database {
+		return &MySQL{}
+	})
+
+	child := container.NewChildContainer(parent)
+
+	var d Database
+	err := child.Make(&d)
+
+	assert.NoError(t, err)
+	if _, ok := d.(*MySQL); !ok {
+		t.Error("Expected MySQL")
+	}
+}
+
+type Postgres struct{}
+
+func (p Postgres) Connect() bool {
+	return true
+}
+
+func TestChildContainerItShouldPreferItsOwnBindingOverParent(t *testing.T) {
+	parent := container.NewContainer()
+	parent.Singleton(func() Database {
+		return &MySQL{}
+	})
+
+	child := container.NewChildContainer(parent)
+	child.Singleton(func() Database {
+		return &Postgres{}
+	})
+
+	var d Database
+	child.Make(&d)
+
+	if _, ok := d.(*Postgres); !ok {
+		t.Error("Expected Postgres")
+	}
+}
+
+func TestChildContainerItShouldCacheParentOwnedSingletonOnTheParent(t *testing.T) {
+	parent := container.NewContainer()
+	parent.Singleton(func() Shape {
+		return &Circle{a: 5}
+	})
+
+	childA := container.NewChildContainer(parent)
+	childB := container.NewChildContainer(parent)
+
+	var sA, sB Shape
+	childA.MakeNamed("", &sA)
+	childB.MakeNamed("", &sB)
+
+	assert.Same(t, sA, sB)
+}
+
+func TestMergeItShouldFlatCopyBindingsFromAnotherContainer(t *testing.T) {
+	moduleA := container.NewContainer()
+	moduleA.Singleton(func() Shape {
+		return &Circle{a: 5}
+	})
+
+	moduleB := container.NewContainer()
+	moduleB.Singleton(func() Database {
+		return &MySQL{}
+	})
+
+	app := container.NewContainer()
+	assert.NoError(t, app.Merge(moduleA))
+	assert.NoError(t, app.Merge(moduleB))
+
+	var (
+		s Shape
+		d Database
+	)
+	app.Make(&s)
+	app.Make(&d)
+
+	if _, ok := s.(*Circle); !ok {
+		t.Error("Expected Circle")
+	}
+	if _, ok := d.(*MySQL); !ok {
+		t.Error("Expected MySQL")
+	}
+}
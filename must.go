@@ -0,0 +1,17 @@
+package container
+
+// MustSingleton calls Singleton and panics if it returns an error. It exists for callers that
+// prefer the original fail-fast behavior over handling the error themselves.
+func MustSingleton(c Container, resolver interface{}) {
+	if err := c.Singleton(resolver); err != nil {
+		panic(err)
+	}
+}
+
+// MustMake calls Make and panics if it returns an error. It exists for callers that prefer the
+// original fail-fast behavior over handling the error themselves.
+func MustMake(c Container, receiver interface{}) {
+	if err := c.Make(receiver); err != nil {
+		panic(err)
+	}
+}
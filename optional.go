@@ -0,0 +1,76 @@
+package container
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// factoryArgumentElem reports whether argType is shaped like a lazy factory argument: a nullary
+// function returning a single non-error value, e.g. func() Shape. ok is false when argType isn't
+// shaped like a factory, in which case elem should be ignored.
+func factoryArgumentElem(argType reflect.Type) (elem reflect.Type, ok bool) {
+	if argType.Kind() != reflect.Func || argType.NumIn() != 0 || argType.NumOut() != 1 || argType.Out(0) == errorType {
+		return nil, false
+	}
+	return argType.Out(0), true
+}
+
+// optionalArgumentElem reports whether argType is shaped like an optional argument: a pointer to
+// an abstraction (interface) type, e.g. *Shape. ok is false when argType isn't a pointer-to-
+// interface, in which case elem should be ignored.
+func optionalArgumentElem(argType reflect.Type) (elem reflect.Type, ok bool) {
+	if argType.Kind() != reflect.Ptr || argType.Elem().Kind() != reflect.Interface {
+		return nil, false
+	}
+	return argType.Elem(), true
+}
+
+// resolveFactoryArgument detects a lazy factory argument (see factoryArgumentElem) and synthesizes
+// a closure that resolves the binding (under name) each time it's called, rather than eagerly at
+// Make time - handy for deferring an expensive dependency, or for taking a dependency that depends
+// back on the caller's own abstraction. Build still walks a factory's element type as an ordinary
+// required dependency, so it reports such a back-reference as a dependency cycle. ok is false when
+// argType isn't shaped like a factory, in which case value is unset and should be ignored.
+func (c *container) resolveFactoryArgument(argType reflect.Type, name string) (value reflect.Value, ok bool) {
+	outType, ok := factoryArgumentElem(argType)
+	if !ok {
+		return reflect.Value{}, false
+	}
+
+	factory := reflect.MakeFunc(argType, func(_ []reflect.Value) []reflect.Value {
+		instance, err := c.resolve(outType, name)
+		if err != nil {
+			panic(err)
+		}
+		if instance == nil {
+			panic(fmt.Errorf("%w: %s", ErrNoBinding, outType.String()))
+		}
+		return []reflect.Value{reflect.ValueOf(instance)}
+	})
+
+	return factory, true
+}
+
+// resolveOptionalArgument detects an optional argument (see optionalArgumentElem). When the
+// abstraction is bound (under name), a pointer to the resolved instance is passed; when it's
+// unbound, a nil pointer of the same type is passed instead of failing with ErrNoBinding. A
+// resolver that itself fails is still propagated as an error. ok is false when argType isn't a
+// pointer-to-interface, in which case value and err should be ignored.
+func (c *container) resolveOptionalArgument(argType reflect.Type, name string) (value reflect.Value, ok bool, err error) {
+	abstraction, ok := optionalArgumentElem(argType)
+	if !ok {
+		return reflect.Value{}, false, nil
+	}
+
+	instance, err := c.resolve(abstraction, name)
+	if err != nil {
+		return reflect.Value{}, true, err
+	}
+	if instance == nil {
+		return reflect.Zero(argType), true, nil
+	}
+
+	ptr := reflect.New(abstraction)
+	ptr.Elem().Set(reflect.ValueOf(instance))
+	return ptr, true, nil
+}
@@ -0,0 +1,69 @@
+package container_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/atrico-go/container"
+)
+
+func TestMakeItShouldInjectALazyFactoryForABoundAbstraction(t *testing.T) {
+	calls := 0
+	c := container.NewContainer()
+	c.Singleton(func() Shape {
+		calls++
+		return &Circle{a: 5}
+	})
+
+	err := c.Make(func(newShape func() Shape) {
+		assert.Equal(t, 0, calls, "resolver should not run until the factory is called")
+		s := newShape()
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, 5, s.GetArea())
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestMakeItShouldLazilyInvokeTheFactoryOnEachCall(t *testing.T) {
+	calls := 0
+	c := container.NewContainer()
+	c.Transient(func() Shape {
+		calls++
+		return &Circle{a: calls}
+	})
+
+	err := c.Make(func(newShape func() Shape) {
+		first := newShape()
+		second := newShape()
+		assert.Equal(t, 1, first.GetArea())
+		assert.Equal(t, 2, second.GetArea())
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestMakeItShouldInjectANonNilOptionalPointerForABoundAbstraction(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton(func() Shape {
+		return &Circle{a: 5}
+	})
+
+	err := c.Make(func(s *Shape) {
+		assert.NotNil(t, s)
+		assert.Equal(t, 5, (*s).GetArea())
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestMakeItShouldInjectANilOptionalPointerForAnUnboundAbstraction(t *testing.T) {
+	c := container.NewContainer()
+
+	err := c.Make(func(s *Shape) {
+		assert.Nil(t, s)
+	})
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,106 @@
+package container_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/atrico-go/container"
+)
+
+func TestSingletonConcurrentMakeItShouldResolveTheResolverExactlyOnce(t *testing.T) {
+	var calls int32
+	var mu sync.Mutex
+
+	c := container.NewContainer()
+	c.Singleton(func() Shape {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		return &Circle{a: 5}
+	})
+
+	const goroutines = 200
+	results := make([]Shape, goroutines)
+
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func(i int) {
+			defer wg.Done()
+			var s Shape
+			assert.NoError(t, c.Make(&s))
+			results[i] = s
+		}(i)
+	}
+	wg.Wait()
+
+	mu.Lock()
+	assert.Equal(t, int32(1), calls)
+	mu.Unlock()
+
+	for _, s := range results {
+		assert.Same(t, results[0], s)
+	}
+}
+
+func TestSingletonConcurrentMakeItShouldRetryAFailingResolverAndKeepPropagatingItsError(t *testing.T) {
+	var calls int32
+	resolverErr := errors.New("boom")
+
+	c := container.NewContainer()
+	c.Singleton(func() (Database, error) {
+		atomic.AddInt32(&calls, 1)
+		return nil, resolverErr
+	})
+
+	const goroutines = 200
+
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			defer wg.Done()
+			var d Database
+			err := c.Make(&d)
+			assert.True(t, errors.Is(err, container.ErrResolverFailed))
+		}()
+	}
+	wg.Wait()
+
+	assert.True(t, atomic.LoadInt32(&calls) > 0)
+
+	// The resolver must still be retried (not permanently poisoned) after the concurrent burst.
+	before := atomic.LoadInt32(&calls)
+	var d Database
+	err := c.Make(&d)
+	assert.True(t, errors.Is(err, container.ErrResolverFailed))
+	assert.Equal(t, before+1, atomic.LoadInt32(&calls))
+}
+
+func TestConcurrentBindAndMakeItShouldNotRace(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton(func() Shape {
+		return &Circle{a: 5}
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			c.TransientNamed("circle-variant", func() Shape {
+				return &Circle{a: i}
+			})
+		}(i)
+		go func() {
+			defer wg.Done()
+			var s Shape
+			c.Make(&s)
+		}()
+	}
+	wg.Wait()
+}